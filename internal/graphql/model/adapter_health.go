@@ -0,0 +1,24 @@
+package model
+
+import "time"
+
+// AdapterHealthState mirrors models.AdapterHealthState for the GraphQL
+// layer so resolvers don't leak server/models types into the schema.
+type AdapterHealthState string
+
+const (
+	AdapterHealthStateHealthy     AdapterHealthState = "HEALTHY"
+	AdapterHealthStateDegraded    AdapterHealthState = "DEGRADED"
+	AdapterHealthStateUnavailable AdapterHealthState = "UNAVAILABLE"
+)
+
+// AdapterHealth is the payload delivered on the adapter health subscription,
+// analogous to OperatorControllerStatus for the MeshSync listener.
+type AdapterHealth struct {
+	AdapterID   string             `json:"adapterID"`
+	Host        string             `json:"host"`
+	State       AdapterHealthState `json:"state"`
+	RTTMillis   int64              `json:"rttMillis"`
+	LastSuccess *time.Time         `json:"lastSuccess"`
+	Error       *Error             `json:"error"`
+}
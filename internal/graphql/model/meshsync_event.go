@@ -0,0 +1,20 @@
+package model
+
+// MeshSyncEventType mirrors meshkit/broker.EventType for the GraphQL layer.
+type MeshSyncEventType string
+
+const (
+	MeshSyncEventTypeAdded    MeshSyncEventType = "ADDED"
+	MeshSyncEventTypeModified MeshSyncEventType = "MODIFIED"
+	MeshSyncEventTypeDeleted  MeshSyncEventType = "DELETED"
+)
+
+// MeshSyncEvent is emitted once per reconciled object, so subscribers get a
+// live stream of applied changes instead of only an error on failure.
+type MeshSyncEvent struct {
+	Type      MeshSyncEventType `json:"type"`
+	ClusterID string            `json:"clusterID"`
+	Kind      string            `json:"kind"`
+	Namespace string            `json:"namespace"`
+	Name      string            `json:"name"`
+}
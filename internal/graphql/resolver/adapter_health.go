@@ -0,0 +1,66 @@
+package resolver
+
+import (
+	"context"
+	"time"
+
+	"github.com/layer5io/meshery/internal/graphql/model"
+	"github.com/layer5io/meshery/server/models"
+)
+
+// listenToAdapterHealthEvents streams AdapterHealthMonitor snapshots to
+// subscribers, analogous to listenToMeshSyncEvents but sourced from
+// models.GlobalAdapterHealthMonitor's polling loop instead of the MeshSync
+// broker.
+func (r *Resolver) listenToAdapterHealthEvents(ctx context.Context) (<-chan *model.AdapterHealth, error) {
+	channel := make(chan *model.AdapterHealth)
+
+	go func(ch chan *model.AdapterHealth) {
+		defer close(ch)
+
+		ticker := time.NewTicker(5 * time.Second)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				for _, h := range models.GlobalAdapterHealthMonitor().All() {
+					select {
+					case ch <- toGQLAdapterHealth(h):
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+		}
+	}(channel)
+
+	return channel, nil
+}
+
+func toGQLAdapterHealth(h models.AdapterHealth) *model.AdapterHealth {
+	state := model.AdapterHealthStateUnavailable
+	switch h.State {
+	case models.AdapterHealthy:
+		state = model.AdapterHealthStateHealthy
+	case models.AdapterDegraded:
+		state = model.AdapterHealthStateDegraded
+	}
+
+	out := &model.AdapterHealth{
+		AdapterID: h.AdapterID,
+		Host:      h.Host,
+		State:     state,
+		RTTMillis: h.RTT.Milliseconds(),
+	}
+	if !h.LastSuccess.IsZero() {
+		lastSuccess := h.LastSuccess
+		out.LastSuccess = &lastSuccess
+	}
+	if h.LastError != "" {
+		out.Error = &model.Error{Code: "", Description: h.LastError}
+	}
+	return out
+}
@@ -0,0 +1,91 @@
+package resolver
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/layer5io/meshkit/broker"
+)
+
+// brokerProviderEnv selects which BrokerProvider backs the MeshSync
+// listener. Defaults to NATS, the only backend Meshery has ever shipped.
+const brokerProviderEnv = "MESHERY_BROKER_PROVIDER"
+
+const (
+	brokerProviderNATS   = "nats"
+	brokerProviderMemory = "memory"
+	brokerProviderKafka  = "kafka"
+)
+
+// defaultMeshsyncSubject/defaultMeshsyncQueue are the historical unsharded
+// subject/queue (formerly the package-level meshsyncSubject/meshsyncQueue
+// vars read directly by listernToEvents). They now only serve as
+// BrokerConfig's fallback when no clusterID is available to shard by.
+const (
+	defaultMeshsyncSubject = "meshery.>"
+	defaultMeshsyncQueue   = "meshery"
+)
+
+// BrokerConfig is what used to be the package-level meshsyncSubject/
+// meshsyncQueue vars, scoped per provider instance instead, so a given
+// Meshery replica can shard MeshSync traffic by cluster
+// ("meshery.<clusterID>.>") instead of every replica both subscribing to
+// and writing every object under the single shared "meshery.>"/"meshery"
+// subject/queue.
+type BrokerConfig struct {
+	Subject string
+	Queue   string
+}
+
+// DefaultBrokerConfig is the historical unsharded subject/queue, used when
+// no clusterID is available to shard by.
+func DefaultBrokerConfig() BrokerConfig {
+	return BrokerConfig{Subject: defaultMeshsyncSubject, Queue: defaultMeshsyncQueue}
+}
+
+// BrokerConfigForCluster scopes Subject to a single cluster so multiple
+// Meshery replicas watching different clusters don't all receive (and
+// write) every other replica's MeshSync objects.
+func BrokerConfigForCluster(clusterID string) BrokerConfig {
+	if clusterID == "" {
+		return DefaultBrokerConfig()
+	}
+	return BrokerConfig{
+		Subject: fmt.Sprintf("meshery.%s.>", clusterID),
+		Queue:   defaultMeshsyncQueue,
+	}
+}
+
+// BrokerProvider decouples listernToEvents from any one message transport.
+// Subscribe delivers messages matching cfg onto ch until Close is called;
+// Publish sends a message on cfg.Subject. Ack is called once a message
+// delivered by Subscribe has been successfully reconciled, so a provider
+// with redelivery semantics (Kafka) can defer its offset commit until
+// then instead of acking on fetch; NATS/in-memory have no such concept
+// and treat Ack as a no-op.
+type BrokerProvider interface {
+	Subscribe(cfg BrokerConfig, ch chan *broker.Message) error
+	Publish(cfg BrokerConfig, msg *broker.Message) error
+	Ack(cfg BrokerConfig, msg *broker.Message) error
+	Close() error
+}
+
+// NewBrokerProvider builds the BrokerProvider named by kind, falling back
+// to the MESHERY_BROKER_PROVIDER env var and then to NATS when kind is
+// empty.
+func NewBrokerProvider(kind string, natsHandler *broker.Handler) (BrokerProvider, error) {
+	if kind == "" {
+		kind = os.Getenv(brokerProviderEnv)
+	}
+	switch strings.ToLower(kind) {
+	case "", brokerProviderNATS:
+		return newNATSProvider(natsHandler), nil
+	case brokerProviderMemory:
+		return newMemoryProvider(), nil
+	case brokerProviderKafka:
+		return newKafkaProvider()
+	default:
+		return nil, fmt.Errorf("unknown %s %q", brokerProviderEnv, kind)
+	}
+}
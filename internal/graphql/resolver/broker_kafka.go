@@ -0,0 +1,169 @@
+package resolver
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/layer5io/meshkit/utils"
+	"github.com/segmentio/kafka-go"
+
+	"github.com/layer5io/meshkit/broker"
+)
+
+// kafkaBrokersEnv/kafkaGroupIDEnv configure the Kafka provider; they're
+// only read when MESHERY_BROKER_PROVIDER=kafka.
+const (
+	kafkaBrokersEnv = "MESHERY_BROKER_KAFKA_BROKERS"
+	kafkaGroupIDEnv = "MESHERY_BROKER_KAFKA_GROUP_ID"
+
+	defaultKafkaGroupID = "meshery"
+)
+
+// kafkaProvider fans MeshSync events out to multiple Meshery replicas via a
+// Kafka consumer group: every replica in the group shares the group ID, so
+// each message on a topic (one topic per cluster subject) is delivered to
+// only one replica in the group - no more duplicate DB writes from two pods
+// both subscribing to every object. Publish sets no message key, so the
+// events of one object are not pinned to one partition or one replica.
+type kafkaProvider struct {
+	brokers []string
+	groupID string
+
+	mu      sync.Mutex
+	readers map[string]*kafka.Reader
+	// pending holds, per topic, the raw kafka.Message fetched for each
+	// broker.Message handed to the caller via Subscribe's channel but not
+	// yet Ack'd. listernToEvents processes one message at a time off a
+	// single channel per Subscribe call, so delivery and Ack both happen
+	// in fetch order - a FIFO per topic is enough to find the right
+	// offset to commit without having to thread it through broker.Message
+	// (a meshkit type we can't add fields to).
+	pending map[string][]kafka.Message
+	writer  *kafka.Writer
+}
+
+func newKafkaProvider() (*kafkaProvider, error) {
+	brokersEnv := os.Getenv(kafkaBrokersEnv)
+	if brokersEnv == "" {
+		return nil, fmt.Errorf("%s must be set to use the kafka broker provider", kafkaBrokersEnv)
+	}
+	groupID := os.Getenv(kafkaGroupIDEnv)
+	if groupID == "" {
+		groupID = defaultKafkaGroupID
+	}
+
+	return &kafkaProvider{
+		brokers: strings.Split(brokersEnv, ","),
+		groupID: groupID,
+		readers: map[string]*kafka.Reader{},
+		pending: map[string][]kafka.Message{},
+	}, nil
+}
+
+// Subscribe fetches messages without auto-committing their offsets
+// (FetchMessage, not ReadMessage): if recordMeshSyncData fails, or the
+// process dies, before Ack is called, the message is redelivered to the
+// consumer group instead of being silently dropped.
+func (p *kafkaProvider) Subscribe(cfg BrokerConfig, ch chan *broker.Message) error {
+	topic := kafkaTopic(cfg)
+
+	p.mu.Lock()
+	reader := kafka.NewReader(kafka.ReaderConfig{
+		Brokers: p.brokers,
+		GroupID: p.groupID,
+		Topic:   topic,
+	})
+	p.readers[cfg.Subject] = reader
+	p.mu.Unlock()
+
+	go func() {
+		for {
+			m, err := reader.FetchMessage(context.Background())
+			if err != nil {
+				// Reader.Close (called from Close) unblocks FetchMessage with
+				// an error; that's our cue to stop, not a delivery failure.
+				return
+			}
+
+			msg := &broker.Message{}
+			if err := utils.Unmarshal(string(m.Value), msg); err != nil {
+				// Unparseable message: commit it now so it doesn't poison
+				// every future redelivery, and move on.
+				_ = reader.CommitMessages(context.Background(), m)
+				continue
+			}
+
+			p.mu.Lock()
+			p.pending[topic] = append(p.pending[topic], m)
+			p.mu.Unlock()
+
+			ch <- msg
+		}
+	}()
+
+	return nil
+}
+
+// Ack commits the offset of the oldest message fetched for cfg's topic
+// that hasn't been committed yet, making the earlier FetchMessage's
+// non-commit durable now that the caller has told us it reconciled
+// successfully.
+func (p *kafkaProvider) Ack(cfg BrokerConfig, msg *broker.Message) error {
+	topic := kafkaTopic(cfg)
+
+	p.mu.Lock()
+	reader := p.readers[cfg.Subject]
+	queue := p.pending[topic]
+	if len(queue) == 0 {
+		p.mu.Unlock()
+		return nil
+	}
+	m := queue[0]
+	p.pending[topic] = queue[1:]
+	p.mu.Unlock()
+
+	if reader == nil {
+		return nil
+	}
+	return reader.CommitMessages(context.Background(), m)
+}
+
+func (p *kafkaProvider) Publish(cfg BrokerConfig, msg *broker.Message) error {
+	p.mu.Lock()
+	if p.writer == nil {
+		p.writer = &kafka.Writer{Addr: kafka.TCP(p.brokers...)}
+	}
+	writer := p.writer
+	p.mu.Unlock()
+
+	body, err := utils.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	return writer.WriteMessages(context.Background(), kafka.Message{
+		Topic: kafkaTopic(cfg),
+		Value: []byte(body),
+	})
+}
+
+func (p *kafkaProvider) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, r := range p.readers {
+		_ = r.Close()
+	}
+	if p.writer != nil {
+		_ = p.writer.Close()
+	}
+	return nil
+}
+
+// kafkaTopic maps a BrokerConfig.Subject (a NATS-shaped subject such as
+// "meshery.>" or "meshery.<clusterID>.>") onto a Kafka topic name, since
+// Kafka topics don't support NATS wildcard syntax.
+func kafkaTopic(cfg BrokerConfig) string {
+	return strings.TrimSuffix(strings.TrimSuffix(cfg.Subject, ">"), ".")
+}
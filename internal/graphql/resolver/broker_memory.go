@@ -0,0 +1,72 @@
+package resolver
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/layer5io/meshkit/broker"
+)
+
+// memoryProvider is an in-process BrokerProvider for tests and
+// single-binary deployments that don't want to stand up NATS/Kafka just to
+// exercise the MeshSync listener.
+type memoryProvider struct {
+	mu          sync.RWMutex
+	subscribers map[string][]chan *broker.Message
+	closed      bool
+}
+
+func newMemoryProvider() *memoryProvider {
+	return &memoryProvider{subscribers: map[string][]chan *broker.Message{}}
+}
+
+func (p *memoryProvider) Subscribe(cfg BrokerConfig, ch chan *broker.Message) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.subscribers[cfg.Subject] = append(p.subscribers[cfg.Subject], ch)
+	return nil
+}
+
+func (p *memoryProvider) Publish(cfg BrokerConfig, msg *broker.Message) error {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if p.closed {
+		return nil
+	}
+	for subject, subs := range p.subscribers {
+		if !subjectMatches(subject, cfg.Subject) {
+			continue
+		}
+		for _, ch := range subs {
+			ch <- msg
+		}
+	}
+	return nil
+}
+
+// Ack is a no-op: an in-process channel has nothing to redeliver.
+func (p *memoryProvider) Ack(cfg BrokerConfig, msg *broker.Message) error {
+	return nil
+}
+
+func (p *memoryProvider) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.closed = true
+	return nil
+}
+
+// subjectMatches implements the small subset of NATS subject-wildcard
+// matching Meshery relies on: an exact match, or a trailing ">" on the
+// subscription side matching any published subject sharing that prefix
+// (e.g. subscription "meshery.>" matches published "meshery.cluster-1.pod").
+func subjectMatches(subscription, published string) bool {
+	if subscription == published {
+		return true
+	}
+	if strings.HasSuffix(subscription, ".>") {
+		prefix := strings.TrimSuffix(subscription, ">")
+		return strings.HasPrefix(published, prefix)
+	}
+	return false
+}
@@ -0,0 +1,35 @@
+package resolver
+
+import (
+	"github.com/layer5io/meshkit/broker"
+)
+
+// natsProvider wraps the existing NATS-backed *broker.Handler behind
+// BrokerProvider, so the NATS path Meshery has always used is just one
+// implementation among several rather than the only option.
+type natsProvider struct {
+	handler *broker.Handler
+}
+
+func newNATSProvider(handler *broker.Handler) *natsProvider {
+	return &natsProvider{handler: handler}
+}
+
+func (p *natsProvider) Subscribe(cfg BrokerConfig, ch chan *broker.Message) error {
+	return p.handler.SubscribeWithChannel(cfg.Subject, cfg.Queue, ch)
+}
+
+func (p *natsProvider) Publish(cfg BrokerConfig, msg *broker.Message) error {
+	return p.handler.Publish(cfg.Subject, msg)
+}
+
+// Ack is a no-op: NATS delivery here is fire-and-forget, with no
+// redelivery to defer.
+func (p *natsProvider) Ack(cfg BrokerConfig, msg *broker.Message) error {
+	return nil
+}
+
+func (p *natsProvider) Close() error {
+	p.handler.Close()
+	return nil
+}
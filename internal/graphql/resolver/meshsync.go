@@ -2,20 +2,56 @@ package resolver
 
 import (
 	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
 
 	"github.com/layer5io/meshery/internal/graphql/model"
+	"github.com/layer5io/meshery/utils/supervisor"
 	"github.com/layer5io/meshkit/broker"
 	"github.com/layer5io/meshkit/database"
 	"github.com/layer5io/meshkit/utils"
 	mesherykube "github.com/layer5io/meshkit/utils/kubernetes"
 	meshsyncmodel "github.com/layer5io/meshsync/pkg/model"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
 )
 
+// meshsyncListenerBackoff bounds how quickly listernToEvents is restarted
+// after a panic; restarting instantly on a DB-shaped panic would just spin.
+var meshsyncListenerBackoff = supervisor.Backoff{Initial: time.Second, Max: 30 * time.Second}
+
 var (
-	meshsyncName    = "meshsync"
-	meshsyncSubject = "meshery.>"
-	meshsyncQueue   = "meshery"
-	meshsyncYaml    = "https://raw.githubusercontent.com/layer5io/meshery-operator/master/config/samples/meshery_v1alpha1_meshsync.yaml"
+	meshsyncName = "meshsync"
+	meshsyncYaml = "https://raw.githubusercontent.com/layer5io/meshery-operator/master/config/samples/meshery_v1alpha1_meshsync.yaml"
+)
+
+// meshsyncClusterIDEnv, when set, shards the MeshSync subject/queue by
+// cluster (via BrokerConfigForCluster) so a Meshery replica watching one
+// cluster doesn't also receive - and re-persist - every other cluster's
+// objects.
+const meshsyncClusterIDEnv = "MESHERY_CLUSTER_ID"
+
+// meshsyncEventsChannel carries one event per object applied by
+// listernToEvents. It's a package-level channel rather than a Resolver
+// field, the same way meshsyncName/meshsyncYaml above are package-level
+// rather than threaded through every caller; listenToMeshSyncObjectEvents
+// is its only consumer. Each event is received by exactly one reader, so
+// concurrent subscriptions split the stream between them rather than each
+// seeing every event.
+var meshsyncEventsChannel = make(chan *model.MeshSyncEvent, 64)
+
+// reconcileRetries/reconcileBackoff bound the retry-on-transient-DB-error
+// loop in recordMeshSyncData, modeled on the apiserver's update-loop: a
+// handful of quick retries is enough to ride out a lock-wait or a
+// momentarily unreachable DB without looping forever.
+const (
+	reconcileRetries = 3
+	reconcileBackoff = 100 * time.Millisecond
 )
 
 func (r *Resolver) getMeshSyncStatus(ctx context.Context) (*model.OperatorControllerStatus, error) {
@@ -26,26 +62,130 @@ func (r *Resolver) listenToMeshSyncEvents(ctx context.Context) (<-chan *model.Op
 	channel := make(chan *model.OperatorControllerStatus)
 	status := model.StatusUnknown
 
+	datach, provider, cfg, err := r.meshsyncDataChannel()
+	if err != nil {
+		return nil, err
+	}
+
 	go func(ch chan *model.OperatorControllerStatus) {
-		err := listernToEvents(r.DBHandler, r.meshsyncChannel)
-		if err != nil {
-			r.Log.Error(err)
-			ch <- &model.OperatorControllerStatus{
+		defer func() {
+			if provider != nil {
+				_ = provider.Close()
+			}
+		}()
+
+		restarts := newRestartWindow(time.Minute)
+
+		supervisor.RunUntil(ctx, r.Log, "meshsync-listener", func(ctx context.Context) error {
+			return listernToEvents(ctx, r.DBHandler, datach, meshsyncEventsChannel, provider, cfg)
+		}, meshsyncListenerBackoff, func(recovered interface{}) {
+			count := restarts.record()
+			select {
+			case ch <- &model.OperatorControllerStatus{
 				Name:   &meshsyncName,
 				Status: &status,
 				Error: &model.Error{
 					Code:        "",
-					Description: err.Error(),
+					Description: fmt.Sprintf("meshsync listener restarted %d× in the last minute: %v", count, recovered),
 				},
+			}:
+			case <-ctx.Done():
 			}
-			return
-		}
-		// extension to notify other channel when data comes in
+		})
+	}(channel)
+
+	return channel, nil
+}
+
+// meshsyncDataChannel picks the channel listernToEvents reads from,
+// honoring MESHERY_BROKER_PROVIDER. The default (empty, or explicitly
+// "nats") keeps today's behavior unchanged: r.meshsyncChannel is already
+// fed by the NATS subscription set up at startup. Selecting "memory" or
+// "kafka" instead builds that BrokerProvider and subscribes it to
+// BrokerConfigForCluster(MESHERY_CLUSTER_ID), so MeshSync traffic can be
+// sharded per cluster and/or fanned out across replicas without every
+// replica writing every object.
+func (r *Resolver) meshsyncDataChannel() (chan *broker.Message, BrokerProvider, BrokerConfig, error) {
+	kind := os.Getenv(brokerProviderEnv)
+	if kind == "" || kind == brokerProviderNATS {
+		return r.meshsyncChannel, nil, BrokerConfig{}, nil
+	}
+
+	provider, err := NewBrokerProvider(kind, nil)
+	if err != nil {
+		return nil, nil, BrokerConfig{}, err
+	}
+
+	cfg := BrokerConfigForCluster(os.Getenv(meshsyncClusterIDEnv))
+	datach := make(chan *broker.Message)
+	if err := provider.Subscribe(cfg, datach); err != nil {
+		return nil, nil, BrokerConfig{}, err
+	}
+	return datach, provider, cfg, nil
+}
+
+// listenToMeshSyncObjectEvents streams one model.MeshSyncEvent per object
+// reconciled by listernToEvents, giving subscribers a live feed of applied
+// changes rather than silence until something breaks.
+func (r *Resolver) listenToMeshSyncObjectEvents(ctx context.Context) (<-chan *model.MeshSyncEvent, error) {
+	channel := make(chan *model.MeshSyncEvent)
+
+	go func(ch chan *model.MeshSyncEvent) {
+		defer close(ch)
+		supervisor.RunUntil(ctx, r.Log, "meshsync-object-events-forwarder", func(ctx context.Context) error {
+			for {
+				select {
+				case <-ctx.Done():
+					return nil
+				case evt, ok := <-meshsyncEventsChannel:
+					if !ok {
+						return nil
+					}
+					select {
+					case ch <- evt:
+					case <-ctx.Done():
+						return nil
+					}
+				}
+			}
+		}, meshsyncListenerBackoff, func(recovered interface{}) {
+			r.Log.Errorf("meshsync object events forwarder restarted: %v", recovered)
+		})
 	}(channel)
 
 	return channel, nil
 }
 
+// restartWindow counts events (e.g. supervised-goroutine restarts) that
+// fall within the trailing `window`, so the UI can say "restarted N× in
+// the last minute" instead of an ever-growing lifetime total.
+type restartWindow struct {
+	mu     sync.Mutex
+	window time.Duration
+	times  []time.Time
+}
+
+func newRestartWindow(window time.Duration) *restartWindow {
+	return &restartWindow{window: window}
+}
+
+func (w *restartWindow) record() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	now := time.Now()
+	cutoff := now.Add(-w.window)
+	kept := w.times[:0]
+	for _, t := range w.times {
+		if t.After(cutoff) {
+			kept = append(kept, t)
+		}
+	}
+	kept = append(kept, now)
+	w.times = kept
+	return len(w.times)
+}
+
 func runMeshSync(client *mesherykube.Client, delete bool) error {
 	err := applyYaml(client, delete, meshsyncYaml)
 	if err != nil {
@@ -54,30 +194,220 @@ func runMeshSync(client *mesherykube.Client, delete bool) error {
 	return nil
 }
 
-func recordMeshSyncData(handler *database.Handler, object meshsyncmodel.Object) error {
-	result := handler.Create(&object)
-	if result.Error != nil {
-		return result.Error
+// objectIdentity is the natural key MeshSync objects are reconciled on:
+// a given Kubernetes object is uniquely identified by its cluster, kind,
+// namespace and name, regardless of how many times MeshSync re-emits it.
+type objectIdentity struct {
+	ClusterID string
+	Kind      string
+	Namespace string
+	Name      string
+}
+
+func identityOf(object *meshsyncmodel.Object) objectIdentity {
+	return objectIdentity{
+		ClusterID: object.ClusterID,
+		Kind:      object.Kind,
+		Namespace: object.ObjectMeta.Namespace,
+		Name:      object.ObjectMeta.Name,
 	}
-	return nil
 }
 
-func listernToEvents(handler *database.Handler, datach chan *broker.Message) error {
+// recordMeshSyncData reconciles a single incoming MeshSync object against
+// the row already persisted for its (cluster, kind, namespace, name),
+// instead of blindly inserting a new row every time MeshSync re-emits the
+// same object. It compares resourceVersion so a stale re-delivery is a
+// no-op, and honors eventType so a DELETED message removes the row rather
+// than upserting a tombstone.
+//
+// Transient DB errors are retried a bounded number of times with a small
+// backoff, and every attempt re-reads the stored row rather than trusting
+// an earlier comparison. A write conflict reported by the database (such
+// as a duplicate key on create) is not treated as transient: it is
+// returned at once, without a re-read.
+func recordMeshSyncData(handler *database.Handler, eventsCh chan *model.MeshSyncEvent, eventType broker.EventType, object meshsyncmodel.Object) error {
+	identity := identityOf(&object)
+
+	var lastErr error
+	for attempt := 0; attempt < reconcileRetries; attempt++ {
+		if attempt > 0 {
+			time.Sleep(reconcileBackoff * time.Duration(attempt))
+		}
+
+		applied, err := reconcileOnce(handler, eventType, identity, object)
+		if err == nil {
+			if applied {
+				emitMeshSyncEvent(eventsCh, eventType, identity)
+			}
+			return nil
+		}
+		lastErr = err
+		if !isTransientDBError(err) {
+			return err
+		}
+	}
+	return lastErr
+}
+
+// ackMessage tells provider the message it delivered via Subscribe has
+// been durably reconciled, so a redelivery-capable provider (Kafka) can
+// commit its offset now rather than on fetch. provider is nil on the
+// default NATS/unconfigured path, which has no such concept.
+func ackMessage(provider BrokerProvider, cfg BrokerConfig, msg *broker.Message) {
+	if provider == nil {
+		return
+	}
+	if err := provider.Ack(cfg, msg); err != nil {
+		logrus.StandardLogger().Errorf("failed to ack meshsync message: %v", err)
+	}
+}
+
+// emitMeshSyncEvent is a best-effort, non-blocking notification: a
+// subscriber that isn't listening (or isn't keeping up) must never stall
+// the reconcile loop itself.
+func emitMeshSyncEvent(eventsCh chan *model.MeshSyncEvent, eventType broker.EventType, identity objectIdentity) {
+	if eventsCh == nil {
+		return
+	}
+	evt := &model.MeshSyncEvent{
+		Type:      gqlEventType(eventType),
+		ClusterID: identity.ClusterID,
+		Kind:      identity.Kind,
+		Namespace: identity.Namespace,
+		Name:      identity.Name,
+	}
+	select {
+	case eventsCh <- evt:
+	default:
+	}
+}
+
+func gqlEventType(eventType broker.EventType) model.MeshSyncEventType {
+	switch eventType {
+	case broker.Add:
+		return model.MeshSyncEventTypeAdded
+	case broker.Delete:
+		return model.MeshSyncEventTypeDeleted
+	default:
+		return model.MeshSyncEventTypeModified
+	}
+}
+
+// reconcileAction is what reconcileOnce should do for an incoming object,
+// given whether a row already exists for its identity and what eventType
+// accompanied it.
+type reconcileAction int
+
+const (
+	reconcileNoop reconcileAction = iota
+	reconcileCreate
+	reconcileUpdate
+	reconcileDelete
+)
+
+// decideReconcileAction implements the resourceVersion/eventType precedence
+// at the heart of the reconciler, factored out of reconcileOnce so it can
+// be unit tested without a database: a DELETED event always wins (or is a
+// no-op if the row's already gone); otherwise an incoming resourceVersion
+// that doesn't advance past what's already stored is a stale/duplicate
+// re-delivery and is dropped.
+func decideReconcileAction(found bool, eventType broker.EventType, incomingRV, existingRV int64) reconcileAction {
+	if eventType == broker.Delete {
+		if !found {
+			return reconcileNoop
+		}
+		return reconcileDelete
+	}
+
+	if found && incomingRV <= existingRV {
+		return reconcileNoop
+	}
+
+	if found {
+		return reconcileUpdate
+	}
+	return reconcileCreate
+}
+
+// reconcileOnce applies the action decideReconcileAction picks and reports
+// whether it wrote anything (false for a no-op).
+func reconcileOnce(handler *database.Handler, eventType broker.EventType, identity objectIdentity, object meshsyncmodel.Object) (bool, error) {
+	var existing meshsyncmodel.Object
+	result := handler.Where(
+		"cluster_id = ? AND kind = ? AND namespace = ? AND name = ?",
+		identity.ClusterID, identity.Kind, identity.Namespace, identity.Name,
+	).First(&existing)
+
+	found := result.Error == nil
+	if result.Error != nil && !errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return false, result.Error
+	}
+
+	switch decideReconcileAction(found, eventType, object.ObjectMeta.ResourceVersion, existing.ObjectMeta.ResourceVersion) {
+	case reconcileNoop:
+		return false, nil
+	case reconcileDelete:
+		return true, handler.Delete(&existing).Error
+	case reconcileUpdate:
+		object.ID = existing.ID
+		return true, handler.Save(&object).Error
+	default: // reconcileCreate
+		return true, handler.Create(&object).Error
+	}
+}
+
+// isTransientDBError decides whether a reconcile attempt is worth retrying.
+// reconcileOnce never returns gorm.ErrRecordNotFound (it's handled inline
+// as found=false), so what reaches here is either a connection-shaped
+// error - a lock wait, a dropped connection, a context deadline - that a
+// bare retry can plausibly ride out, or a permanent one - bad data, a
+// constraint violation, a schema mismatch - that will fail identically on
+// every attempt. Only the former is worth the retry budget; defaulting to
+// "transient" for anything unrecognized would retry (and then still
+// bubble up and restart the listener goroutine for) errors that can never
+// succeed.
+func isTransientDBError(err error) bool {
+	return errors.Is(err, driver.ErrBadConn) ||
+		errors.Is(err, sql.ErrConnDone) ||
+		errors.Is(err, context.DeadlineExceeded) ||
+		errors.Is(err, context.Canceled)
+}
+
+// listernToEvents drains datach, reconciling each incoming object and
+// emitting a typed change event on eventsCh once it has been applied
+// (stale or duplicate re-deliveries emit nothing), so subscribers see a live stream of changes instead of only
+// hearing about it when something goes wrong. It returns cleanly when ctx
+// is cancelled or datach is closed, rather than spinning forever.
+//
+// provider/cfg identify the BrokerProvider datach came from (both are the
+// zero value on the default NATS/unconfigured path); once an object is
+// successfully reconciled, provider is Ack'd so a redelivery-capable
+// provider can commit its offset only now, not on fetch.
+func listernToEvents(ctx context.Context, handler *database.Handler, datach chan *broker.Message, eventsCh chan *model.MeshSyncEvent, provider BrokerProvider, cfg BrokerConfig) error {
 	for {
 		select {
-		case msg := <-datach:
-			objectJSON, _ := utils.Marshal(msg.Object)
+		case <-ctx.Done():
+			return nil
+		case msg, ok := <-datach:
+			if !ok {
+				return nil
+			}
+
+			objectJSON, err := utils.Marshal(msg.Object)
+			if err != nil {
+				return err
+			}
 			object := meshsyncmodel.Object{}
-			err := utils.Unmarshal(string(objectJSON), &object)
+			err = utils.Unmarshal(string(objectJSON), &object)
 			if err != nil {
 				return err
 			}
 
-			// persist the object
-			err = recordMeshSyncData(handler, object)
+			err = recordMeshSyncData(handler, eventsCh, msg.EventType, object)
 			if err != nil {
 				return err
 			}
+			ackMessage(provider, cfg, msg)
 		}
 	}
 }
@@ -0,0 +1,35 @@
+package resolver
+
+import (
+	"testing"
+
+	"github.com/layer5io/meshkit/broker"
+)
+
+func TestDecideReconcileAction(t *testing.T) {
+	cases := []struct {
+		name       string
+		found      bool
+		eventType  broker.EventType
+		incomingRV int64
+		existingRV int64
+		want       reconcileAction
+	}{
+		{"create when not found", false, broker.Add, 1, 0, reconcileCreate},
+		{"update on newer resourceVersion", true, broker.Modify, 2, 1, reconcileUpdate},
+		{"noop on equal resourceVersion", true, broker.Modify, 1, 1, reconcileNoop},
+		{"noop on stale resourceVersion", true, broker.Modify, 1, 2, reconcileNoop},
+		{"delete when found", true, broker.Delete, 5, 5, reconcileDelete},
+		{"delete noop when already gone", false, broker.Delete, 5, 0, reconcileNoop},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := decideReconcileAction(c.found, c.eventType, c.incomingRV, c.existingRV)
+			if got != c.want {
+				t.Errorf("decideReconcileAction(%v, %v, %d, %d) = %v, want %v",
+					c.found, c.eventType, c.incomingRV, c.existingRV, got, c.want)
+			}
+		})
+	}
+}
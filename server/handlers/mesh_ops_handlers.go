@@ -6,7 +6,6 @@ import (
 	"encoding/gob"
 	"encoding/json"
 	"fmt"
-	"net"
 	"net/http"
 	"strings"
 
@@ -21,6 +20,14 @@ func init() {
 	gob.Register([]*models.Adapter{})
 }
 
+// ErrAdapterUnavailable is returned by MeshOpsHandler when the
+// AdapterHealthMonitor's circuit breaker for an adapter is open, so callers
+// short-circuit with a 503 instead of blocking on a dead adapter's
+// CreateClient.
+func ErrAdapterUnavailable(host string) error {
+	return fmt.Errorf("adapter %s is currently unavailable", host)
+}
+
 // swagger:route GET /api/system/adapters/available SystemAPI idGetAvailableAdapters
 // Handle GET request for available adapters
 //
@@ -95,8 +102,13 @@ func (h *Handler) AdapterPingHandler(w http.ResponseWriter, req *http.Request, p
 		return
 	}
 
-	if !checkAdapterPingability(targetAdapter) {
-		// h.log.Error("Adapter ping failed")
+	// Ping runs a live probe rather than trusting the cached breaker state:
+	// IsAvailable only reflects the periodic loop's last result, which for
+	// a just-Register'd adapter defaults to "available" despite zero probes
+	// having run, and for a dead adapter can still say "available" for up
+	// to consecutiveFailuresToTrip probe intervals after it went down.
+	health := models.GlobalAdapterHealthMonitor().Ping(req.Context(), targetAdapter.Host)
+	if health.LastError != "" {
 		http.Error(w, "Adapter ping failed", http.StatusInternalServerError)
 		return
 	}
@@ -104,19 +116,30 @@ func (h *Handler) AdapterPingHandler(w http.ResponseWriter, req *http.Request, p
 	_, _ = w.Write([]byte("{}"))
 }
 
-func checkAdapterPingability(adapter *models.Adapter) bool {
-	// Custom logic to check if the adapter is pingable
-	// For example, you can establish a TCP connection to the adapter's port
-	address := fmt.Sprintf("localhost:%d", adapter.Port)
-	conn, err := net.Dial("tcp", address)
-	if err != nil {
-		// Error occurred while establishing the TCP connection
-		return false
+// swagger:route GET /api/system/adapters/health SystemAPI idGetAdaptersHealth
+// Handle GET request for adapter health
+//
+// Fetches the last known health snapshot (state/RTT/last success) for every
+// adapter tracked by the AdapterHealthMonitor
+// Responses:
+//  200: systemAdaptersHealthRespWrapper
+
+// AdapterHealthHandler is used to fetch the health snapshot of every
+// registered adapter, replacing the localhost-only boolean probe in
+// checkAdapterPingability with real per-adapter observability.
+func (h *Handler) AdapterHealthHandler(w http.ResponseWriter, req *http.Request, _ *models.Preference, _ *models.User, _ models.Provider) {
+	if req.Method != http.MethodGet {
+		w.WriteHeader(http.StatusNotFound)
+		return
 	}
-	defer conn.Close()
 
-	// Successfully established the TCP connection
-	return true
+	err := json.NewEncoder(w).Encode(models.GlobalAdapterHealthMonitor().All())
+	if err != nil {
+		obj := "data"
+		h.log.Error(ErrMarshal(err, obj))
+		http.Error(w, ErrMarshal(err, obj).Error(), http.StatusInternalServerError)
+		return
+	}
 }
 
 // swagger:route POST /api/system/adapter/manage SystemAPI idPostAdapterConfig
@@ -231,8 +254,10 @@ func (h *Handler) addAdapter(ctx context.Context, meshAdapters []*models.Adapter
 	// Set the initial availability to true
 	adapter.Available = true
 
-	// Start the background goroutine to check the availability periodically
-	models.CheckAdapterAvailability(adapter)
+	// Hand the adapter to the health monitor instead of spawning a
+	// fire-and-forget availability goroutine; the monitor's own probe loop
+	// now owns polling this adapter for as long as it stays registered.
+	models.GlobalAdapterHealthMonitor().Register(adapter)
 
 	h.config.AdapterTracker.AddAdapter(ctx, *adapter)
 	meshAdapters = append(meshAdapters, adapter)
@@ -258,6 +283,8 @@ func (h *Handler) deleteAdapter(meshAdapters []*models.Adapter, w http.ResponseW
 		return meshAdapters, ErrValidAdapter
 	}
 
+	models.GlobalAdapterHealthMonitor().Unregister(adapterLoc)
+
 	newMeshAdapters := []*models.Adapter{}
 	if aID == 0 {
 		newMeshAdapters = meshAdapters[1:]
@@ -321,6 +348,19 @@ func (h *Handler) MeshOpsHandler(w http.ResponseWriter, req *http.Request, prefO
 		namespace = "default"
 	}
 
+	// A non-empty opCategory means customBody is a portable SMI resource
+	// (TrafficSplit/TrafficTarget/HTTPRouteGroup/TCPRoute) that needs
+	// translating into this adapter's native config before ApplyOperation.
+	if opCategory := meshes.OpCategory(req.FormValue("opCategory")); opCategory != "" {
+		var err error
+		opName, customBody, err = translateSMIBody(meshAdapters[aID].Name, opCategory, opName, customBody)
+		if err != nil {
+			h.log.Error(err)
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
 	mk8sContexts, ok := req.Context().Value(models.KubeClustersKey).([]models.K8sContext)
 	if !ok || len(mk8sContexts) == 0 {
 		h.log.Error(ErrInvalidK8SConfig)
@@ -339,6 +379,12 @@ func (h *Handler) MeshOpsHandler(w http.ResponseWriter, req *http.Request, prefO
 		configs = append(configs, string(kc))
 	}
 
+	if !models.GlobalAdapterHealthMonitor().IsAvailable(meshAdapters[aID].Host) {
+		h.log.Error(ErrAdapterUnavailable(meshAdapters[aID].Host))
+		http.Error(w, ErrAdapterUnavailable(meshAdapters[aID].Host).Error(), http.StatusServiceUnavailable)
+		return
+	}
+
 	mClient, err := meshes.CreateClient(req.Context(), meshAdapters[aID].Host)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/layer5io/meshery/server/meshes"
+	"github.com/layer5io/meshery/server/models"
+)
+
+// swagger:route GET /api/system/adapter/smi/capabilities SystemAPI idGetAdapterSMICapabilities
+// Handle GET request for SMI capabilities
+//
+// Aggregates the SMI resources (TrafficSplit, TrafficTarget, HTTPRouteGroup,
+// TCPRoute) each registered adapter can translate, so the UI can hide
+// unsupported knobs
+// Responses:
+//  200: systemAdapterSMICapabilitiesRespWrapper
+
+// AdapterSMICapabilitiesHandler reports, per adapter name, which SMI
+// resource kinds have a registered models.SMITranslator.
+func (h *Handler) AdapterSMICapabilitiesHandler(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	err := json.NewEncoder(w).Encode(models.SMICapabilities())
+	if err != nil {
+		obj := "data"
+		h.log.Error(ErrMarshal(err, obj))
+		http.Error(w, ErrMarshal(err, obj).Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// translateSMIBody rewrites an SMI customBody into the adapter-native
+// opName/body pair ApplyOperation expects, when opCategory names one of the
+// OpCategory_SMI_* families. Operations outside that family pass through
+// opName/customBody unchanged.
+func translateSMIBody(adapterName string, opCategory meshes.OpCategory, opName, customBody string) (string, string, error) {
+	switch opCategory {
+	case meshes.OpCategory_SMI_TRAFFIC_SPLIT, meshes.OpCategory_SMI_TRAFFIC_TARGET,
+		meshes.OpCategory_SMI_HTTP_ROUTE_GROUP, meshes.OpCategory_SMI_TCP_ROUTE:
+		translator, ok := models.SMITranslatorFor(adapterName)
+		if !ok {
+			return "", "", models.ErrNoSMITranslator(adapterName)
+		}
+		return translator.Translate(opCategory, customBody)
+	default:
+		return opName, customBody, nil
+	}
+}
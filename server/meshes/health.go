@@ -0,0 +1,7 @@
+package meshes
+
+// MeshServiceName is the fully-qualified gRPC service name adapters expose,
+// used as the `service` field of grpc_health_v1.HealthCheckRequest so the
+// health checking protocol probes MeshService specifically rather than the
+// server-wide default service.
+const MeshServiceName = "meshes.MeshService"
@@ -0,0 +1,31 @@
+package meshes
+
+// OpCategory identifies the broad family an adapter operation belongs to,
+// so handlers and the UI can group/filter ApplyRuleRequest.OpName values
+// without hardcoding adapter-specific operation names.
+type OpCategory string
+
+const (
+	// OpCategory_SMI_TRAFFIC_SPLIT marks operations that apply an SMI
+	// TrafficSplit resource (weighted routing across backends).
+	OpCategory_SMI_TRAFFIC_SPLIT OpCategory = "SMI_TRAFFIC_SPLIT"
+	// OpCategory_SMI_TRAFFIC_TARGET marks operations that apply an SMI
+	// TrafficTarget resource (mTLS/access policy between identities).
+	OpCategory_SMI_TRAFFIC_TARGET OpCategory = "SMI_TRAFFIC_TARGET"
+	// OpCategory_SMI_HTTP_ROUTE_GROUP marks operations that apply an SMI
+	// HTTPRouteGroup resource (HTTP route matching rules).
+	OpCategory_SMI_HTTP_ROUTE_GROUP OpCategory = "SMI_HTTP_ROUTE_GROUP"
+	// OpCategory_SMI_TCP_ROUTE marks operations that apply an SMI TCPRoute
+	// resource (port-based route matching rules).
+	OpCategory_SMI_TCP_ROUTE OpCategory = "SMI_TCP_ROUTE"
+)
+
+// SMIResourceKinds lists the Kubernetes `kind` values Meshery recognizes as
+// SMI spec.customBody payloads, in the order GET .../smi/capabilities
+// reports them.
+var SMIResourceKinds = []string{
+	"TrafficSplit",
+	"TrafficTarget",
+	"HTTPRouteGroup",
+	"TCPRoute",
+}
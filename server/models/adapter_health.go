@@ -0,0 +1,364 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/layer5io/meshery/server/meshes"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// AdapterHealthState represents the last known state of an adapter as
+// observed by the AdapterHealthMonitor.
+type AdapterHealthState string
+
+const (
+	// AdapterHealthy means the adapter answered its health probe within
+	// the configured timeout.
+	AdapterHealthy AdapterHealthState = "healthy"
+	// AdapterDegraded means the adapter's latest probe failed, but it has
+	// not yet failed enough consecutive probes for its circuit breaker to
+	// trip open. Round trip time does not affect this state.
+	AdapterDegraded AdapterHealthState = "degraded"
+	// AdapterUnavailable means the adapter has failed enough consecutive
+	// probes for its circuit breaker to trip open.
+	AdapterUnavailable AdapterHealthState = "unavailable"
+)
+
+const (
+	// consecutiveFailuresToTrip is the number of consecutive failed probes
+	// after which an adapter's circuit breaker opens.
+	consecutiveFailuresToTrip = 3
+	// consecutiveFailuresToDegrade flags an adapter as degraded before the
+	// breaker actually trips, so operators get an early warning.
+	consecutiveFailuresToDegrade = 1
+	// initialBackoff is the half-open probe delay after a breaker first trips.
+	initialBackoff = 2 * time.Second
+	// maxBackoff caps the exponential backoff between half-open probes.
+	maxBackoff = 2 * time.Minute
+	// probeTimeout bounds a single health probe attempt.
+	probeTimeout = 3 * time.Second
+)
+
+// AdapterHealth is the point-in-time health snapshot for a single adapter.
+type AdapterHealth struct {
+	AdapterID   string             `json:"adapterID"`
+	Host        string             `json:"host"`
+	State       AdapterHealthState `json:"state"`
+	RTT         time.Duration      `json:"rtt"`
+	LastSuccess time.Time          `json:"lastSuccess"`
+	LastError   string             `json:"lastError,omitempty"`
+}
+
+// circuitBreaker tracks consecutive-failure based trip/half-open/reset state
+// for a single adapter, independent of the monitor's polling loop.
+type circuitBreaker struct {
+	mu                  sync.Mutex
+	consecutiveFailures int
+	open                bool
+	backoff             time.Duration
+	nextProbeAt         time.Time
+}
+
+// allowProbe reports whether the breaker currently permits a probe: always
+// when closed, and only after the backoff window elapses when open
+// (the "half-open" probe).
+func (cb *circuitBreaker) allowProbe() bool {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	if !cb.open {
+		return true
+	}
+	return !time.Now().Before(cb.nextProbeAt)
+}
+
+func (cb *circuitBreaker) recordSuccess() {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	cb.consecutiveFailures = 0
+	cb.open = false
+	cb.backoff = 0
+}
+
+func (cb *circuitBreaker) recordFailure() {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	cb.consecutiveFailures++
+	if cb.consecutiveFailures < consecutiveFailuresToTrip {
+		return
+	}
+	if cb.backoff == 0 {
+		cb.backoff = initialBackoff
+	} else {
+		cb.backoff *= 2
+		if cb.backoff > maxBackoff {
+			cb.backoff = maxBackoff
+		}
+	}
+	cb.open = true
+	cb.nextProbeAt = time.Now().Add(cb.backoff)
+}
+
+// isOpen reports the breaker's actual trip state. It must NOT factor in
+// whether the backoff window has elapsed: allowProbe uses that to permit a
+// single half-open trial probe, but until that trial probe actually
+// succeeds (recordSuccess), the breaker is still open and callers gating
+// real traffic (IsAvailable) must keep short-circuiting.
+func (cb *circuitBreaker) isOpen() bool {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	return cb.open
+}
+
+// failures returns the current consecutive-failure count, used to decide
+// the Degraded/Unavailable boundary in probeOne.
+func (cb *circuitBreaker) failures() int {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	return cb.consecutiveFailures
+}
+
+// AdapterHealthMonitor periodically probes every registered adapter over the
+// gRPC Health Checking Protocol (falling back to a raw TCP dial for adapters
+// that predate health service support), and keeps a circuit breaker per
+// adapter so callers can short-circuit instead of blocking on a dead
+// adapter's CreateClient.
+//
+// It replaces the old fire-and-forget CheckAdapterAvailability goroutine and
+// the localhost-only TCP probe in checkAdapterPingability: adapters are
+// probed at their registered meshLocationURL, not at "localhost:<port>".
+type AdapterHealthMonitor struct {
+	log logrus.FieldLogger
+
+	mu       sync.RWMutex
+	health   map[string]*AdapterHealth
+	breakers map[string]*circuitBreaker
+
+	interval time.Duration
+}
+
+// NewAdapterHealthMonitor creates a monitor that probes adapters every
+// interval once Start is called.
+func NewAdapterHealthMonitor(log logrus.FieldLogger, interval time.Duration) *AdapterHealthMonitor {
+	if interval <= 0 {
+		interval = 10 * time.Second
+	}
+	return &AdapterHealthMonitor{
+		log:      log,
+		health:   map[string]*AdapterHealth{},
+		breakers: map[string]*circuitBreaker{},
+		interval: interval,
+	}
+}
+
+// Register adds (or re-registers) an adapter for periodic probing.
+func (m *AdapterHealthMonitor) Register(adapter *Adapter) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	id := adapter.Host
+	if _, ok := m.health[id]; !ok {
+		m.health[id] = &AdapterHealth{AdapterID: id, Host: adapter.Host, State: AdapterUnavailable}
+	}
+	if _, ok := m.breakers[id]; !ok {
+		m.breakers[id] = &circuitBreaker{}
+	}
+}
+
+// Unregister drops an adapter from monitoring, e.g. when it is deleted via
+// MeshAdapterConfigHandler.
+func (m *AdapterHealthMonitor) Unregister(host string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.health, host)
+	delete(m.breakers, host)
+}
+
+// Start runs the probe loop until ctx is cancelled.
+func (m *AdapterHealthMonitor) Start(ctx context.Context) {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			m.probeAll(ctx)
+		}
+	}
+}
+
+func (m *AdapterHealthMonitor) probeAll(ctx context.Context) {
+	m.mu.RLock()
+	hosts := make([]string, 0, len(m.health))
+	for host := range m.health {
+		hosts = append(hosts, host)
+	}
+	m.mu.RUnlock()
+
+	for _, host := range hosts {
+		m.probeOne(ctx, host)
+	}
+}
+
+func (m *AdapterHealthMonitor) probeOne(ctx context.Context, host string) {
+	m.mu.RLock()
+	cb := m.breakers[host]
+	m.mu.RUnlock()
+	if cb == nil || !cb.allowProbe() {
+		return
+	}
+	m.probeAndRecord(ctx, host, cb)
+}
+
+// probeAndRecord runs a single probe against host and records its outcome
+// against cb, updating the stored AdapterHealth. Unlike probeOne, it does
+// not consult cb.allowProbe first - probeOne is the periodic loop, which
+// must respect backoff; Ping is an explicit, user-triggered check that
+// should always run regardless of where the breaker's backoff window is.
+func (m *AdapterHealthMonitor) probeAndRecord(ctx context.Context, host string, cb *circuitBreaker) AdapterHealth {
+	probeCtx, cancel := context.WithTimeout(ctx, probeTimeout)
+	defer cancel()
+
+	start := time.Now()
+	err := probeAdapter(probeCtx, host)
+	rtt := time.Since(start)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	h, ok := m.health[host]
+	if !ok {
+		h = &AdapterHealth{AdapterID: host, Host: host}
+		m.health[host] = h
+	}
+	h.RTT = rtt
+	if err != nil {
+		cb.recordFailure()
+		h.LastError = err.Error()
+		switch {
+		case cb.isOpen():
+			h.State = AdapterUnavailable
+		case cb.failures() >= consecutiveFailuresToDegrade:
+			h.State = AdapterDegraded
+		}
+		m.log.Debugf("adapter %s failed health probe: %v", host, err)
+		return *h
+	}
+
+	cb.recordSuccess()
+	h.LastError = ""
+	h.LastSuccess = time.Now()
+	h.State = AdapterHealthy
+	return *h
+}
+
+// Ping runs an immediate, synchronous probe against host and returns the
+// resulting health, bypassing the periodic loop's allowProbe/backoff gate.
+// It's what AdapterPingHandler calls: a caller asking "is this adapter up
+// right now" should get a live answer, not whatever the last periodic
+// probe (up to m.interval ago) happened to record, and shouldn't have to
+// wait out a tripped breaker's backoff window to get one.
+func (m *AdapterHealthMonitor) Ping(ctx context.Context, host string) AdapterHealth {
+	m.mu.Lock()
+	cb, ok := m.breakers[host]
+	if !ok {
+		cb = &circuitBreaker{}
+		m.breakers[host] = cb
+	}
+	m.mu.Unlock()
+
+	return m.probeAndRecord(ctx, host, cb)
+}
+
+// probeAdapter performs a single health check against host, preferring the
+// gRPC Health Checking Protocol against the adapter's meshes.MeshService and
+// falling back to a TCP dial for adapters that don't implement it.
+func probeAdapter(ctx context.Context, host string) error {
+	conn, err := grpc.DialContext(ctx, host, grpc.WithInsecure(), grpc.WithBlock())
+	if err == nil {
+		defer func() {
+			_ = conn.Close()
+		}()
+
+		healthClient := grpc_health_v1.NewHealthClient(conn)
+		resp, herr := healthClient.Check(ctx, &grpc_health_v1.HealthCheckRequest{Service: meshes.MeshServiceName})
+		if herr == nil && resp.GetStatus() == grpc_health_v1.HealthCheckResponse_SERVING {
+			return nil
+		}
+		if herr == nil {
+			return fmt.Errorf("adapter %s reports status %s", host, resp.GetStatus())
+		}
+	}
+
+	return tcpProbe(ctx, host)
+}
+
+// tcpProbe is the pre-existing fallback probe for adapters that don't speak
+// the gRPC Health Checking Protocol yet.
+func tcpProbe(ctx context.Context, host string) error {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", host)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+var (
+	globalHealthMonitor     *AdapterHealthMonitor
+	globalHealthMonitorOnce sync.Once
+)
+
+// GlobalAdapterHealthMonitor returns the process-wide AdapterHealthMonitor,
+// constructing it and starting its probe loop on first use. Handlers and
+// resolvers reach the monitor through this accessor rather than through a
+// config/Resolver field, the same way ListAvailableAdapters and other
+// cross-cutting adapter state are exposed as package-level singletons
+// here rather than threaded through every caller.
+func GlobalAdapterHealthMonitor() *AdapterHealthMonitor {
+	globalHealthMonitorOnce.Do(func() {
+		globalHealthMonitor = NewAdapterHealthMonitor(logrus.StandardLogger(), 10*time.Second)
+		go globalHealthMonitor.Start(context.Background())
+	})
+	return globalHealthMonitor
+}
+
+// Health returns the last known health snapshot for host.
+func (m *AdapterHealthMonitor) Health(host string) (AdapterHealth, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	h, ok := m.health[host]
+	if !ok {
+		return AdapterHealth{}, false
+	}
+	return *h, true
+}
+
+// All returns a snapshot of every monitored adapter's health, used by the
+// /api/system/adapters/health endpoint and the adapter health subscription.
+func (m *AdapterHealthMonitor) All() []AdapterHealth {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	out := make([]AdapterHealth, 0, len(m.health))
+	for _, h := range m.health {
+		out = append(out, *h)
+	}
+	return out
+}
+
+// IsAvailable reports whether host's circuit breaker is currently closed;
+// false means callers should short-circuit instead of dialing the adapter.
+// A host the monitor has no breaker for is reported available.
+func (m *AdapterHealthMonitor) IsAvailable(host string) bool {
+	m.mu.RLock()
+	cb, ok := m.breakers[host]
+	m.mu.RUnlock()
+	if !ok {
+		return true
+	}
+	return !cb.isOpen()
+}
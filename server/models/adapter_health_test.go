@@ -0,0 +1,67 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCircuitBreakerTripsAfterConsecutiveFailures(t *testing.T) {
+	cb := &circuitBreaker{}
+
+	for i := 0; i < consecutiveFailuresToTrip-1; i++ {
+		cb.recordFailure()
+		if cb.isOpen() {
+			t.Fatalf("breaker opened after %d failures, want it closed until %d", i+1, consecutiveFailuresToTrip)
+		}
+	}
+
+	cb.recordFailure()
+	if !cb.isOpen() {
+		t.Fatalf("breaker should be open after %d consecutive failures", consecutiveFailuresToTrip)
+	}
+}
+
+func TestCircuitBreakerStaysOpenUntilSuccessfulProbe(t *testing.T) {
+	cb := &circuitBreaker{}
+	for i := 0; i < consecutiveFailuresToTrip; i++ {
+		cb.recordFailure()
+	}
+	if !cb.isOpen() {
+		t.Fatal("breaker should be open after tripping")
+	}
+
+	// Force the backoff window to have already elapsed: allowProbe should
+	// now permit a half-open trial probe, but isOpen must still report
+	// open (and therefore IsAvailable must still report unavailable) until
+	// that trial probe actually records a success.
+	cb.nextProbeAt = time.Now().Add(-time.Second)
+
+	if !cb.allowProbe() {
+		t.Fatal("allowProbe should permit a half-open trial probe once backoff elapses")
+	}
+	if !cb.isOpen() {
+		t.Fatal("isOpen must stay true until a probe actually succeeds, regardless of elapsed backoff")
+	}
+
+	cb.recordSuccess()
+	if cb.isOpen() {
+		t.Fatal("breaker should close after a successful probe")
+	}
+	if !cb.allowProbe() {
+		t.Fatal("a closed breaker should always allow probing")
+	}
+}
+
+func TestCircuitBreakerRecordSuccessResetsFailures(t *testing.T) {
+	cb := &circuitBreaker{}
+	cb.recordFailure()
+	cb.recordFailure()
+	cb.recordSuccess()
+
+	if cb.failures() != 0 {
+		t.Fatalf("failures() = %d, want 0 after recordSuccess", cb.failures())
+	}
+	if cb.isOpen() {
+		t.Fatal("breaker should not be open after recordSuccess")
+	}
+}
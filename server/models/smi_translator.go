@@ -0,0 +1,59 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/layer5io/meshery/server/meshes"
+)
+
+// SMITranslator turns a portable SMI resource (TrafficSplit, TrafficTarget,
+// HTTPRouteGroup, TCPRoute, given as the raw customBody the user POSTed)
+// into the adapter-native config its ApplyOperation expects, e.g. an Istio
+// VirtualService/DestinationRule pair or a Linkerd ServiceProfile.
+//
+// Adapters that don't support a given SMI resource simply omit it from
+// Capabilities(), which is what GET /api/system/adapter/smi/capabilities
+// reports to let the UI hide the corresponding knob.
+type SMITranslator interface {
+	// Capabilities lists the SMI resource kinds (meshes.SMIResourceKinds
+	// entries) this adapter can translate.
+	Capabilities() []string
+	// Translate converts customBody (the raw SMI resource YAML/JSON) for
+	// opCategory into the adapter-native body ApplyOperation should send,
+	// along with the adapter-native OpName to invoke.
+	Translate(opCategory meshes.OpCategory, customBody string) (opName string, nativeBody string, err error)
+}
+
+// smiTranslators is keyed by the adapter Name returned from ComponentInfo
+// (e.g. "istio", "linkerd"), mirroring how models.Adapter already carries
+// Name/Ops sourced from ComponentInfo/SupportedOperations.
+var smiTranslators = map[string]SMITranslator{}
+
+// RegisterSMITranslator adds (or replaces) the SMI translator for an
+// adapter. Adapters register themselves from their own init(), the same
+// pattern ListAvailableAdapters uses for static adapter metadata.
+func RegisterSMITranslator(adapterName string, translator SMITranslator) {
+	smiTranslators[adapterName] = translator
+}
+
+// SMITranslatorFor looks up the translator registered for adapterName.
+func SMITranslatorFor(adapterName string) (SMITranslator, bool) {
+	t, ok := smiTranslators[adapterName]
+	return t, ok
+}
+
+// SMICapabilities aggregates every registered adapter's supported SMI
+// resources, keyed by adapter name, for the smi/capabilities endpoint.
+func SMICapabilities() map[string][]string {
+	out := make(map[string][]string, len(smiTranslators))
+	for name, t := range smiTranslators {
+		out[name] = t.Capabilities()
+	}
+	return out
+}
+
+// ErrNoSMITranslator is returned when MeshOpsHandler is asked to translate
+// an SMI resource for an adapter that hasn't registered a translator.
+func ErrNoSMITranslator(adapterName string) error {
+	return fmt.Errorf("adapter %q does not support SMI operations", adapterName)
+}
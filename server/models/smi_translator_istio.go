@@ -0,0 +1,179 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/layer5io/meshery/server/meshes"
+	"gopkg.in/yaml.v2"
+)
+
+// istioSMITranslator turns SMI resources into the Istio CRDs that provide
+// the equivalent behavior: a TrafficSplit becomes a weighted-route
+// VirtualService, a TrafficTarget becomes an AuthorizationPolicy
+// restricting which source identities may reach the destination, and an
+// HTTPRouteGroup/TCPRoute becomes the match fragment a VirtualService
+// route embeds to scope itself the same way.
+type istioSMITranslator struct{}
+
+func init() {
+	RegisterSMITranslator("istio", istioSMITranslator{})
+}
+
+func (istioSMITranslator) Capabilities() []string {
+	return []string{"TrafficSplit", "TrafficTarget", "HTTPRouteGroup", "TCPRoute"}
+}
+
+func (t istioSMITranslator) Translate(opCategory meshes.OpCategory, customBody string) (string, string, error) {
+	switch opCategory {
+	case meshes.OpCategory_SMI_TRAFFIC_SPLIT:
+		return t.translateTrafficSplit(customBody)
+	case meshes.OpCategory_SMI_TRAFFIC_TARGET:
+		return t.translateTrafficTarget(customBody)
+	case meshes.OpCategory_SMI_HTTP_ROUTE_GROUP:
+		return t.translateHTTPRouteGroup(customBody)
+	case meshes.OpCategory_SMI_TCP_ROUTE:
+		return t.translateTCPRoute(customBody)
+	default:
+		return "", "", fmt.Errorf("istio adapter does not support SMI resource for opCategory %q", opCategory)
+	}
+}
+
+// translateTrafficSplit maps each weighted backend directly to its own
+// route destination, since SMI's TrafficSplit backends are already
+// distinct Kubernetes Services (not label-selected subsets of one
+// Service) - there's no version/label data anywhere in a TrafficSplit to
+// build a DestinationRule subset from, so this doesn't try to fabricate
+// one; it routes straight to each backend's host the way the apex
+// service's weighted split actually works.
+func (istioSMITranslator) translateTrafficSplit(customBody string) (string, string, error) {
+	var split smiTrafficSplit
+	if err := yaml.Unmarshal([]byte(customBody), &split); err != nil {
+		return "", "", fmt.Errorf("parsing TrafficSplit: %w", err)
+	}
+
+	namespace := split.Metadata.Namespace
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	var routes strings.Builder
+	for _, b := range split.Spec.Backends {
+		fmt.Fprintf(&routes, "      - destination:\n          host: %s\n        weight: %d\n", b.Service, b.Weight)
+	}
+
+	virtualService := fmt.Sprintf(`apiVersion: networking.istio.io/v1beta1
+kind: VirtualService
+metadata:
+  name: %s
+  namespace: %s
+spec:
+  hosts:
+    - %s
+  http:
+    - route:
+%s`, split.Metadata.Name, namespace, split.Spec.Service, routes.String())
+
+	return "ApplyVirtualService", virtualService, nil
+}
+
+func (istioSMITranslator) translateTrafficTarget(customBody string) (string, string, error) {
+	var target smiTrafficTarget
+	if err := yaml.Unmarshal([]byte(customBody), &target); err != nil {
+		return "", "", fmt.Errorf("parsing TrafficTarget: %w", err)
+	}
+
+	namespace := target.Metadata.Namespace
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	var principals strings.Builder
+	for _, s := range target.Spec.Sources {
+		fmt.Fprintf(&principals, "          - %q\n", s.Name)
+	}
+
+	policy := fmt.Sprintf(`apiVersion: security.istio.io/v1beta1
+kind: AuthorizationPolicy
+metadata:
+  name: %s
+  namespace: %s
+spec:
+  selector:
+    matchLabels:
+      account: %s
+  rules:
+    - from:
+        - source:
+            principals:
+%s`, target.Metadata.Name, namespace, target.Spec.Destination.Name, principals.String())
+
+	return "ApplyAuthorizationPolicy", policy, nil
+}
+
+// translateHTTPRouteGroup turns an HTTPRouteGroup's match rules into an
+// Istio HTTPMatchRequest list: SMI's spec has a TrafficTarget's `rules`
+// reference an HTTPRouteGroup by name to scope an access policy to
+// specific paths/methods, and Istio expresses that same scoping as
+// `http.match` entries on a VirtualService route - so unlike
+// TrafficSplit/TrafficTarget there's no single adapter-native CRD this
+// maps onto on its own, just the match fragment a VirtualService route
+// would embed.
+func (istioSMITranslator) translateHTTPRouteGroup(customBody string) (string, string, error) {
+	var group smiHTTPRouteGroup
+	if err := yaml.Unmarshal([]byte(customBody), &group); err != nil {
+		return "", "", fmt.Errorf("parsing HTTPRouteGroup: %w", err)
+	}
+
+	var matches strings.Builder
+	for _, m := range group.Spec.Matches {
+		fmt.Fprintf(&matches, "  - name: %s\n", m.Name)
+		if m.PathRegex != "" {
+			fmt.Fprintf(&matches, "    uri:\n      regex: %q\n", m.PathRegex)
+		}
+		if len(m.Methods) > 0 {
+			fmt.Fprintf(&matches, "    method:\n      regex: %q\n", strings.Join(m.Methods, "|"))
+		}
+	}
+
+	fragment := fmt.Sprintf(`# HTTPMatchRequest fragment for %s; embed under a VirtualService's
+# spec.http[].match to scope that route the way this HTTPRouteGroup does.
+match:
+%s`, group.Metadata.Name, matches.String())
+
+	return "ApplyHTTPRouteMatches", fragment, nil
+}
+
+// translateTCPRoute turns a TCPRoute's port matches into an Istio
+// VirtualService TCPRoute match fragment, the TCP equivalent of
+// translateHTTPRouteGroup.
+func (istioSMITranslator) translateTCPRoute(customBody string) (string, string, error) {
+	var route smiTCPRoute
+	if err := yaml.Unmarshal([]byte(customBody), &route); err != nil {
+		return "", "", fmt.Errorf("parsing TCPRoute: %w", err)
+	}
+
+	var matches strings.Builder
+	for _, m := range route.Spec.Matches {
+		fmt.Fprintf(&matches, "  - name: %s\n    port: %d\n", m.Name, firstPort(m.Ports))
+	}
+
+	fragment := fmt.Sprintf(`# TCP match fragment for %s; embed under a VirtualService's spec.tcp[].match
+# to scope that route the way this TCPRoute does.
+match:
+%s`, route.Metadata.Name, matches.String())
+
+	return "ApplyTCPRouteMatches", fragment, nil
+}
+
+// firstPort returns the first configured port, or 0 if none were given;
+// Istio's TCPMatchAttributes only matches a single port per rule, while
+// SMI's TCPRoute allows a list, so a multi-port TCPRoute needs one
+// VirtualService match entry per port - firstPort keeps this translation
+// honest about only covering the single-port case today.
+func firstPort(ports []int) int {
+	if len(ports) == 0 {
+		return 0
+	}
+	return ports[0]
+}
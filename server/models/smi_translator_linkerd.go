@@ -0,0 +1,80 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/layer5io/meshery/server/meshes"
+	"gopkg.in/yaml.v2"
+)
+
+// linkerdSMITranslator targets Linkerd, which implements SMI's TrafficSplit
+// CRD natively, so that resource passes through unchanged; a TrafficTarget
+// is translated into a Linkerd ServiceProfile with an empty route list and
+// a spec-level authorization block naming the destination and its allowed
+// clients, rather than a dedicated CRD the way Istio's AuthorizationPolicy
+// does.
+type linkerdSMITranslator struct{}
+
+func init() {
+	RegisterSMITranslator("linkerd", linkerdSMITranslator{})
+}
+
+func (linkerdSMITranslator) Capabilities() []string {
+	return []string{"TrafficSplit", "TrafficTarget"}
+}
+
+func (t linkerdSMITranslator) Translate(opCategory meshes.OpCategory, customBody string) (string, string, error) {
+	switch opCategory {
+	case meshes.OpCategory_SMI_TRAFFIC_SPLIT:
+		return t.translateTrafficSplit(customBody)
+	case meshes.OpCategory_SMI_TRAFFIC_TARGET:
+		return t.translateTrafficTarget(customBody)
+	default:
+		return "", "", fmt.Errorf("linkerd adapter does not support SMI resource for opCategory %q", opCategory)
+	}
+}
+
+// translateTrafficSplit checks that the TrafficSplit parses and then passes
+// customBody through verbatim:
+// Linkerd's own traffic-split controller already reads split.smi-spec.io
+// objects directly, so there's no adapter-native shape to translate into -
+// Meshery just needs to apply the resource Linkerd already understands.
+func (linkerdSMITranslator) translateTrafficSplit(customBody string) (string, string, error) {
+	var split smiTrafficSplit
+	if err := yaml.Unmarshal([]byte(customBody), &split); err != nil {
+		return "", "", fmt.Errorf("parsing TrafficSplit: %w", err)
+	}
+	return "ApplyTrafficSplit", customBody, nil
+}
+
+func (linkerdSMITranslator) translateTrafficTarget(customBody string) (string, string, error) {
+	var target smiTrafficTarget
+	if err := yaml.Unmarshal([]byte(customBody), &target); err != nil {
+		return "", "", fmt.Errorf("parsing TrafficTarget: %w", err)
+	}
+
+	namespace := target.Metadata.Namespace
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	var clients strings.Builder
+	for _, s := range target.Spec.Sources {
+		fmt.Fprintf(&clients, "      - %s\n", s.Name)
+	}
+
+	serviceProfile := fmt.Sprintf(`apiVersion: linkerd.io/v1alpha2
+kind: ServiceProfile
+metadata:
+  name: %s
+  namespace: %s
+spec:
+  routes: []
+  authorization:
+    destination: %s
+    allowedClients:
+%s`, target.Metadata.Name, namespace, target.Spec.Destination.Name, clients.String())
+
+	return "ApplyServiceProfile", serviceProfile, nil
+}
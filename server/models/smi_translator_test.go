@@ -0,0 +1,193 @@
+package models
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/layer5io/meshery/server/meshes"
+)
+
+func TestIstioSMITranslator(t *testing.T) {
+	tr := istioSMITranslator{}
+
+	cases := []struct {
+		name        string
+		opCategory  meshes.OpCategory
+		customBody  string
+		wantOpName  string
+		wantContain []string
+	}{
+		{
+			name:       "TrafficSplit routes directly to each backend, no fabricated subset",
+			opCategory: meshes.OpCategory_SMI_TRAFFIC_SPLIT,
+			customBody: `
+metadata:
+  name: my-split
+  namespace: my-ns
+spec:
+  service: my-svc
+  backends:
+    - service: my-svc-v1
+      weight: 90
+    - service: my-svc-v2
+      weight: 10
+`,
+			wantOpName: "ApplyVirtualService",
+			wantContain: []string{
+				"kind: VirtualService",
+				"name: my-split",
+				"namespace: my-ns",
+				"host: my-svc-v1",
+				"weight: 90",
+				"host: my-svc-v2",
+				"weight: 10",
+			},
+		},
+		{
+			name:       "TrafficTarget lists sources as AuthorizationPolicy principals",
+			opCategory: meshes.OpCategory_SMI_TRAFFIC_TARGET,
+			customBody: `
+metadata:
+  name: my-target
+  namespace: my-ns
+spec:
+  destination:
+    kind: ServiceAccount
+    name: dest-account
+  sources:
+    - kind: ServiceAccount
+      name: src-account
+`,
+			wantOpName: "ApplyAuthorizationPolicy",
+			wantContain: []string{
+				"kind: AuthorizationPolicy",
+				"account: dest-account",
+				`"src-account"`,
+			},
+		},
+		{
+			name:       "HTTPRouteGroup becomes an http match fragment",
+			opCategory: meshes.OpCategory_SMI_HTTP_ROUTE_GROUP,
+			customBody: `
+metadata:
+  name: my-routes
+spec:
+  matches:
+    - name: get-root
+      pathRegex: /
+      methods:
+        - GET
+`,
+			wantOpName: "ApplyHTTPRouteMatches",
+			wantContain: []string{
+				"name: get-root",
+				`regex: "/"`,
+				`regex: "GET"`,
+			},
+		},
+		{
+			name:       "TCPRoute becomes a tcp match fragment",
+			opCategory: meshes.OpCategory_SMI_TCP_ROUTE,
+			customBody: `
+metadata:
+  name: my-tcp
+spec:
+  matches:
+    - name: db
+      ports:
+        - 5432
+`,
+			wantOpName: "ApplyTCPRouteMatches",
+			wantContain: []string{
+				"name: db",
+				"port: 5432",
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			opName, body, err := tr.Translate(c.opCategory, c.customBody)
+			if err != nil {
+				t.Fatalf("Translate returned error: %v", err)
+			}
+			if opName != c.wantOpName {
+				t.Errorf("opName = %q, want %q", opName, c.wantOpName)
+			}
+			for _, want := range c.wantContain {
+				if !strings.Contains(body, want) {
+					t.Errorf("translated body missing %q:\n%s", want, body)
+				}
+			}
+		})
+	}
+}
+
+func TestIstioSMITranslatorUnsupportedOpCategory(t *testing.T) {
+	tr := istioSMITranslator{}
+	if _, _, err := tr.Translate(meshes.OpCategory("unknown"), ""); err == nil {
+		t.Fatal("expected an error for an unsupported opCategory")
+	}
+}
+
+func TestLinkerdSMITranslator(t *testing.T) {
+	tr := linkerdSMITranslator{}
+
+	t.Run("TrafficSplit passes through unchanged", func(t *testing.T) {
+		body := `
+metadata:
+  name: my-split
+spec:
+  service: my-svc
+  backends:
+    - service: my-svc-v1
+      weight: 100
+`
+		opName, got, err := tr.Translate(meshes.OpCategory_SMI_TRAFFIC_SPLIT, body)
+		if err != nil {
+			t.Fatalf("Translate returned error: %v", err)
+		}
+		if opName != "ApplyTrafficSplit" {
+			t.Errorf("opName = %q, want ApplyTrafficSplit", opName)
+		}
+		if got != body {
+			t.Errorf("expected TrafficSplit to pass through verbatim, got:\n%s", got)
+		}
+	})
+
+	t.Run("TrafficTarget becomes a ServiceProfile with allowedClients", func(t *testing.T) {
+		body := `
+metadata:
+  name: my-target
+  namespace: my-ns
+spec:
+  destination:
+    kind: ServiceAccount
+    name: dest-svc
+  sources:
+    - kind: ServiceAccount
+      name: src-account
+`
+		opName, got, err := tr.Translate(meshes.OpCategory_SMI_TRAFFIC_TARGET, body)
+		if err != nil {
+			t.Fatalf("Translate returned error: %v", err)
+		}
+		if opName != "ApplyServiceProfile" {
+			t.Errorf("opName = %q, want ApplyServiceProfile", opName)
+		}
+		for _, want := range []string{"kind: ServiceProfile", "name: my-target", "destination: dest-svc", "- src-account"} {
+			if !strings.Contains(got, want) {
+				t.Errorf("translated body missing %q:\n%s", want, got)
+			}
+		}
+	})
+
+	t.Run("HTTPRouteGroup/TCPRoute are not supported", func(t *testing.T) {
+		if _, _, err := tr.Translate(meshes.OpCategory_SMI_HTTP_ROUTE_GROUP, ""); err == nil {
+			t.Fatal("expected an error: linkerd translator does not implement HTTPRouteGroup")
+		}
+		if _, _, err := tr.Translate(meshes.OpCategory_SMI_TCP_ROUTE, ""); err == nil {
+			t.Fatal("expected an error: linkerd translator does not implement TCPRoute")
+		}
+	})
+}
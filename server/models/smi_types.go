@@ -0,0 +1,79 @@
+package models
+
+// The SMI resource shapes below are the minimal subset of each spec
+// Meshery's translators need (identity + the routing/weight fields), not a
+// full copy of the upstream CRDs. customBody is parsed into these before
+// SMITranslator.Translate turns them into adapter-native config.
+
+// smiMeta mirrors the Kubernetes-style metadata every SMI resource carries.
+type smiMeta struct {
+	Name      string `yaml:"name"`
+	Namespace string `yaml:"namespace"`
+}
+
+// smiTrafficSplitBackend is one weighted backend of a TrafficSplit.
+type smiTrafficSplitBackend struct {
+	Service string `yaml:"service"`
+	Weight  int    `yaml:"weight"`
+}
+
+// smiTrafficSplit is the split.smi-spec.io/v1alpha4 TrafficSplit shape:
+// an apex service fanned out across weighted backend services.
+type smiTrafficSplit struct {
+	Metadata smiMeta `yaml:"metadata"`
+	Spec     struct {
+		Service  string                   `yaml:"service"`
+		Backends []smiTrafficSplitBackend `yaml:"backends"`
+	} `yaml:"spec"`
+}
+
+// smiTrafficTargetIdentity names a source/destination identity in a
+// TrafficTarget (typically a ServiceAccount).
+type smiTrafficTargetIdentity struct {
+	Kind string `yaml:"kind"`
+	Name string `yaml:"name"`
+}
+
+// smiTrafficTarget is the access.smi-spec.io/v1alpha3 TrafficTarget shape:
+// an access policy allowing sources to reach a destination.
+type smiTrafficTarget struct {
+	Metadata smiMeta `yaml:"metadata"`
+	Spec     struct {
+		Destination smiTrafficTargetIdentity   `yaml:"destination"`
+		Sources     []smiTrafficTargetIdentity `yaml:"sources"`
+	} `yaml:"spec"`
+}
+
+// smiHTTPMatch is one match rule of an HTTPRouteGroup.
+type smiHTTPMatch struct {
+	Name      string   `yaml:"name"`
+	PathRegex string   `yaml:"pathRegex"`
+	Methods   []string `yaml:"methods"`
+}
+
+// smiHTTPRouteGroup is the specs.smi-spec.io/v1alpha4 HTTPRouteGroup shape:
+// a named set of HTTP match rules, referenced by a TrafficTarget's `rules`
+// to scope an access policy to specific paths/methods rather than an
+// entire destination.
+type smiHTTPRouteGroup struct {
+	Metadata smiMeta `yaml:"metadata"`
+	Spec     struct {
+		Matches []smiHTTPMatch `yaml:"matches"`
+	} `yaml:"spec"`
+}
+
+// smiTCPMatch is one match rule of a TCPRoute.
+type smiTCPMatch struct {
+	Name  string `yaml:"name"`
+	Ports []int  `yaml:"ports"`
+}
+
+// smiTCPRoute is the specs.smi-spec.io/v1alpha4 TCPRoute shape: a named set
+// of port match rules, referenced the same way smiHTTPRouteGroup is but for
+// non-HTTP traffic.
+type smiTCPRoute struct {
+	Metadata smiMeta `yaml:"metadata"`
+	Spec     struct {
+		Matches []smiTCPMatch `yaml:"matches"`
+	} `yaml:"spec"`
+}
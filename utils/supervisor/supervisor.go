@@ -0,0 +1,131 @@
+// Package supervisor provides panic recovery and restart helpers for the
+// long-lived goroutines that back Meshery's resolvers (MeshSync listener,
+// operator status watchers, broker subscribers). Without it, a panic in
+// unmarshal or DB code inside one of those goroutines takes down the whole
+// server with no chance to restart.
+package supervisor
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+)
+
+// restartsTotal counts goroutine restarts by name, whether fn panicked or
+// returned an error, so operators can alert on a watcher that's
+// crash-looping.
+var restartsTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "meshery_supervisor_goroutine_restarts_total",
+		Help: "Number of times a supervised goroutine has been restarted after a panic or error.",
+	},
+	[]string{"name"},
+)
+
+func init() {
+	prometheus.MustRegister(restartsTotal)
+}
+
+// Backoff configures the capped exponential backoff RunUntil applies
+// between restarts.
+type Backoff struct {
+	Initial time.Duration
+	Max     time.Duration
+}
+
+func (b Backoff) next(attempt int) time.Duration {
+	if b.Initial <= 0 {
+		b.Initial = time.Second
+	}
+	if b.Max <= 0 {
+		b.Max = time.Minute
+	}
+	d := b.Initial
+	for i := 0; i < attempt; i++ {
+		d *= 2
+		if d >= b.Max {
+			return b.Max
+		}
+	}
+	return d
+}
+
+// HandleCrash recovers a panic in the calling goroutine, logs the stack
+// trace via logger, and invokes onPanic with the recovered value so callers
+// can surface a restart event (e.g. onto a GraphQL subscription channel).
+// It is a no-op, and must be deferred, when there is nothing to recover.
+func HandleCrash(logger logrus.FieldLogger, onPanic func(recovered interface{})) {
+	if r := recover(); r != nil {
+		if logger != nil {
+			logger.Errorf("recovered from panic: %v\n%s", r, debug.Stack())
+		}
+		if onPanic != nil {
+			onPanic(r)
+		}
+	}
+}
+
+// RunUntil runs fn in a loop, recovering any panic and restarting fn with a
+// capped exponential backoff, until ctx is cancelled.
+// A clean return from fn (err == nil, no panic) is NOT restarted: fn is
+// expected to return nil exactly when it's done for good (ctx cancelled,
+// its input channel closed, ...), so treating that like a crash would spin
+// RunUntil forever on an intentional, orderly shutdown. onRestart is only
+// called, with the panic/error value, when fn actually failed.
+func RunUntil(ctx context.Context, logger logrus.FieldLogger, name string, fn func(ctx context.Context) error, backoff Backoff, onRestart func(recovered interface{})) {
+	attempt := 0
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		err := runOnce(ctx, logger, name, fn)
+		if err == nil {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		restartsTotal.WithLabelValues(name).Inc()
+		if onRestart != nil {
+			onRestart(err)
+		}
+
+		wait := backoff.next(attempt)
+		attempt++
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(wait):
+		}
+	}
+}
+
+// runOnce invokes fn once, converting a panic into an error so RunUntil's
+// restart/backoff bookkeeping is the same whether fn panicked or returned
+// an error.
+func runOnce(ctx context.Context, logger logrus.FieldLogger, name string, fn func(ctx context.Context) error) (err error) {
+	defer HandleCrash(logger, func(recovered interface{}) {
+		err = &panicError{name: name, recovered: recovered}
+	})
+	return fn(ctx)
+}
+
+type panicError struct {
+	name      string
+	recovered interface{}
+}
+
+func (p *panicError) Error() string {
+	return fmt.Sprintf("%s: panic: %v", p.name, p.recovered)
+}